@@ -0,0 +1,198 @@
+// Package cluster implements gossip-style peer coordination for the Quantum Policy
+// Evaluator, so a Measure/Observe collapse becomes durable only once a quorum of peers
+// independently recomputed the same outcome, instead of one Redis instance unilaterally
+// deciding a policy's fate. Peer discovery is a static, operator-supplied list; the "gossip"
+// itself is a broadcast/vote round over Redis Pub/Sub, the same cross-replica mechanism
+// qpe_service/stream.go already uses for watch-event fan-out.
+package cluster
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/go-redis/redis/v8"
+)
+
+const (
+	// prepareChannel is the shared channel every peer's Coordinator listens on for new
+	// collapse proposals.
+	prepareChannel = "qpe:cluster:prepare"
+	// voteChannelPrefix is suffixed with a round ID to give each PrepareCollapse round its
+	// own reply channel.
+	voteChannelPrefix = "qpe:cluster:vote:"
+	// defaultVoteTimeout bounds how long PrepareCollapse waits for peers to vote before
+	// giving up on the round.
+	defaultVoteTimeout = 500 * time.Millisecond
+)
+
+// CollapseProposal carries everything a peer needs to independently recompute the same
+// collapse outcome the proposer is about to commit: the RNG seed (so a probabilistic
+// collapse is reproducible), the current superposition weights, the reason driving the
+// collapse rule, and the proposer's criticality bias and effective uncertainty λ (see
+// qpe_service's collapseWaveFunctionBiased). Bias and Lambda travel with the proposal instead
+// of being re-resolved locally by each peer, so a PolicyConfigWatcher reload racing a quorum
+// round can't make two peers recompute from different tuning than the proposer used.
+type CollapseProposal struct {
+	RoundID        string      `json:"round_id"`
+	PolicyID       string      `json:"policy_id"`
+	ProposerID     string      `json:"proposer_id"`
+	Seed           int64       `json:"seed"`
+	WeightApproved float32     `json:"weight_approved"`
+	WeightRejected float32     `json:"weight_rejected"`
+	WeightPending  float32     `json:"weight_pending"`
+	Reason         string      `json:"reason"`
+	Criticality    string      `json:"criticality"`
+	Bias           *[3]float32 `json:"bias,omitempty"`
+	Lambda         float32     `json:"lambda"`
+}
+
+// Vote is one peer's independently recomputed state for a CollapseProposal round.
+type Vote struct {
+	RoundID string `json:"round_id"`
+	NodeID  string `json:"node_id"`
+	State   int32  `json:"state"`
+	Err     string `json:"err,omitempty"`
+}
+
+// Recompute independently derives the collapsed state from a proposal the same way the
+// proposer did. It's supplied by the caller (qpe_service/quorum.go) so this package doesn't
+// need to depend on QPE's internal policy/weight types.
+type Recompute func(p CollapseProposal) (state int32, err error)
+
+// Coordinator runs quorum-signed collapse rounds over a Redis-backed gossip channel.
+type Coordinator struct {
+	NodeID      string
+	RedisClient *redis.Client
+	Peers       []string // informational peer list; quorum is decided by vote count, not identity
+	QuorumSize  int
+	Recompute   Recompute
+	VoteTimeout time.Duration
+}
+
+// NewCoordinator builds a Coordinator. quorumSize is the number of agreeing votes (including
+// the proposer's own) required for PrepareCollapse to consider a round successful.
+func NewCoordinator(nodeID string, redisClient *redis.Client, peers []string, quorumSize int, recompute Recompute) *Coordinator {
+	return &Coordinator{
+		NodeID:      nodeID,
+		RedisClient: redisClient,
+		Peers:       peers,
+		QuorumSize:  quorumSize,
+		Recompute:   recompute,
+		VoteTimeout: defaultVoteTimeout,
+	}
+}
+
+// Run subscribes to the shared prepare channel and answers every proposal it sees (including
+// this node's own, looped back through Redis like every other peer) with its independently
+// recomputed vote. It must be running on every peer for PrepareCollapse rounds to reach
+// quorum, and blocks until ctx is canceled.
+func (c *Coordinator) Run(ctx context.Context) {
+	pubsub := c.RedisClient.Subscribe(ctx, prepareChannel)
+	defer pubsub.Close()
+
+	ch := pubsub.Channel()
+	for {
+		var msg *redis.Message
+		select {
+		case <-ctx.Done():
+			return
+		case m, ok := <-ch:
+			if !ok {
+				return
+			}
+			msg = m
+		}
+
+		var proposal CollapseProposal
+		if err := json.Unmarshal([]byte(msg.Payload), &proposal); err != nil {
+			continue
+		}
+
+		vote := Vote{RoundID: proposal.RoundID, NodeID: c.NodeID}
+		state, err := c.Recompute(proposal)
+		if err != nil {
+			vote.Err = err.Error()
+		} else {
+			vote.State = state
+		}
+
+		payload, err := json.Marshal(vote)
+		if err != nil {
+			continue
+		}
+		c.RedisClient.Publish(ctx, voteChannelPrefix+proposal.RoundID, payload)
+	}
+}
+
+// PrepareCollapse broadcasts proposal under roundID and waits up to VoteTimeout for votes,
+// including this node's own (looped back through Redis, same as every other peer, by virtue
+// of Run also subscribing locally). It returns the agreed state and true once at least
+// QuorumSize peers vote for the same state; otherwise it returns the most popular state seen
+// so far (for logging) and false, so the caller can attribute the collapse to a
+// QUORUM_MISMATCH reason and force re-measurement rather than committing a disputed outcome.
+func (c *Coordinator) PrepareCollapse(ctx context.Context, roundID string, proposal CollapseProposal) (state int32, reached bool, err error) {
+	proposal.RoundID = roundID
+	proposal.ProposerID = c.NodeID
+
+	voteSub := c.RedisClient.Subscribe(ctx, voteChannelPrefix+roundID)
+	defer voteSub.Close()
+
+	payload, err := json.Marshal(proposal)
+	if err != nil {
+		return 0, false, fmt.Errorf("failed to marshal collapse proposal: %w", err)
+	}
+	if err := c.RedisClient.Publish(ctx, prepareChannel, payload).Err(); err != nil {
+		return 0, false, fmt.Errorf("failed to broadcast collapse proposal: %w", err)
+	}
+
+	timeout := c.VoteTimeout
+	if timeout <= 0 {
+		timeout = defaultVoteTimeout
+	}
+	deadline := time.NewTimer(timeout)
+	defer deadline.Stop()
+
+	tally := make(map[int32]int)
+	seen := make(map[string]bool)
+
+	for {
+		select {
+		case <-ctx.Done():
+			return 0, false, ctx.Err()
+		case <-deadline.C:
+			return bestState(tally), false, nil
+		case msg, ok := <-voteSub.Channel():
+			if !ok {
+				return bestState(tally), false, nil
+			}
+			var vote Vote
+			if err := json.Unmarshal([]byte(msg.Payload), &vote); err != nil {
+				continue
+			}
+			if vote.Err != "" || seen[vote.NodeID] {
+				continue
+			}
+			seen[vote.NodeID] = true
+			tally[vote.State]++
+			if tally[vote.State] >= c.QuorumSize {
+				return vote.State, true, nil
+			}
+		}
+	}
+}
+
+// bestState returns the state with the most votes in tally (0 if tally is empty), purely for
+// diagnostics when a round fails to reach quorum.
+func bestState(tally map[int32]int) int32 {
+	var best int32
+	var bestCount int
+	for state, count := range tally {
+		if count > bestCount {
+			best = state
+			bestCount = count
+		}
+	}
+	return best
+}
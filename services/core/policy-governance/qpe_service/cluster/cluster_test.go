@@ -0,0 +1,92 @@
+package cluster
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/go-redis/redis/v8"
+	"github.com/stretchr/testify/require"
+)
+
+// newTestCoordinator builds a Coordinator against a shared miniredis instance, with
+// VoteTimeout shortened so a round that can never reach quorum fails fast instead of
+// blocking the test for defaultVoteTimeout.
+func newTestCoordinator(t *testing.T, addr, nodeID string, quorumSize int, recompute Recompute) *Coordinator {
+	t.Helper()
+	client := redis.NewClient(&redis.Options{Addr: addr})
+	t.Cleanup(func() { client.Close() })
+
+	c := NewCoordinator(nodeID, client, nil, quorumSize, recompute)
+	c.VoteTimeout = 200 * time.Millisecond
+	return c
+}
+
+// agreeingRecompute always returns the same state, simulating peers whose weights/seed
+// inputs are identical and so independently derive the same outcome.
+func agreeingRecompute(state int32) Recompute {
+	return func(p CollapseProposal) (int32, error) { return state, nil }
+}
+
+func TestPrepareCollapseReachesQuorum(t *testing.T) {
+	mr, err := miniredis.Run()
+	require.NoError(t, err)
+	defer mr.Close()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	proposer := newTestCoordinator(t, mr.Addr(), "node-a", 2, agreeingRecompute(1))
+	peer := newTestCoordinator(t, mr.Addr(), "node-b", 2, agreeingRecompute(1))
+
+	go proposer.Run(ctx)
+	go peer.Run(ctx)
+	time.Sleep(50 * time.Millisecond) // let both Run loops subscribe before proposing
+
+	state, reached, err := proposer.PrepareCollapse(ctx, "round-1", CollapseProposal{PolicyID: "p1", Reason: "MEASUREMENT"})
+	require.NoError(t, err)
+	require.True(t, reached, "two peers agreeing on the same state must reach a quorum of 2")
+	require.Equal(t, int32(1), state)
+}
+
+func TestPrepareCollapseTimesOutWithoutQuorum(t *testing.T) {
+	mr, err := miniredis.Run()
+	require.NoError(t, err)
+	defer mr.Close()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	// Only the proposer itself votes (via its own Run loop looping the proposal back through
+	// Redis); a quorum size of 2 can never be reached by one vote, so the round must time out.
+	proposer := newTestCoordinator(t, mr.Addr(), "node-a", 2, agreeingRecompute(1))
+	go proposer.Run(ctx)
+	time.Sleep(50 * time.Millisecond)
+
+	_, reached, err := proposer.PrepareCollapse(ctx, "round-2", CollapseProposal{PolicyID: "p1", Reason: "MEASUREMENT"})
+	require.NoError(t, err)
+	require.False(t, reached, "a lone voter must never satisfy a quorum size of 2")
+}
+
+func TestPrepareCollapseDisagreeingVotesFailQuorum(t *testing.T) {
+	mr, err := miniredis.Run()
+	require.NoError(t, err)
+	defer mr.Close()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	// Two peers that recompute different states (e.g. a seed/weights mismatch) must never
+	// let a round commit a disputed outcome: neither state alone reaches the quorum size of 2.
+	proposer := newTestCoordinator(t, mr.Addr(), "node-a", 2, agreeingRecompute(0))
+	peer := newTestCoordinator(t, mr.Addr(), "node-b", 2, agreeingRecompute(1))
+
+	go proposer.Run(ctx)
+	go peer.Run(ctx)
+	time.Sleep(50 * time.Millisecond)
+
+	_, reached, err := proposer.PrepareCollapse(ctx, "round-3", CollapseProposal{PolicyID: "p1", Reason: "MEASUREMENT"})
+	require.NoError(t, err)
+	require.False(t, reached, "disagreeing peers must fail the round as a QUORUM_MISMATCH rather than committing either state")
+}
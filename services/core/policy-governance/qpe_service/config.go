@@ -0,0 +1,236 @@
+// Dynamic config hot-reload for the Quantum Policy Evaluator.
+// SetUncertainty already lets an operator change the global uncertainty λ at runtime; this
+// file extends the same idea to the per-criticality collapse bias collapseWaveFunctionBiased
+// consults (previously only the HIGH-criticality special case was adjustable, and only by a
+// code change) and to per-policy overrides, without a restart. PolicyConfigWatcher subscribes
+// to qpe:config:updates, the same broadcast-then-relay Redis Pub/Sub mechanism stream.go's
+// eventHub and cluster.go's Coordinator use, and swaps the active PolicyConfig behind an
+// atomic.Value so readers never see a partially-applied update.
+
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+
+	pb "qpe_service/proto"
+)
+
+const (
+	// configUpdatesChannel is the Redis Pub/Sub channel a PolicyConfigWatcher subscribes to;
+	// publishing a full PolicyConfig JSON document to it triggers a reload on every replica.
+	configUpdatesChannel = "qpe:config:updates"
+	// configDebounceWindow coalesces a burst of messages on configUpdatesChannel (e.g.
+	// several pushes from one operator script) into a single reload of the last one.
+	configDebounceWindow = 200 * time.Millisecond
+)
+
+var configReloadsTotal = promauto.NewCounter(
+	prometheus.CounterOpts{
+		Name: "qpe_config_reloads_total",
+		Help: "Total number of successful dynamic policy config reloads",
+	},
+)
+
+// PolicyOverride holds per-policy overrides for fields PolicyConfig otherwise applies
+// globally or per-criticality; a nil field means "no override, fall back to the global or
+// criticality default".
+type PolicyOverride struct {
+	Lambda *float32    `json:"lambda,omitempty"`
+	Bias   *[3]float32 `json:"bias,omitempty"`
+}
+
+// PolicyConfig is the hot-reloadable half of QPE's tuning knobs. CriticalityBias maps a
+// Criticality label (LOW/MEDIUM/HIGH/CRITICAL) to an additive [approved, rejected, pending]
+// adjustment collapseWaveFunctionBiased blends into a policy's weights before a probabilistic
+// collapse. Every PolicyConfigWatcher update replaces the whole document, so a reload is
+// always a consistent, fully-formed snapshot rather than a partial patch.
+type PolicyConfig struct {
+	Lambda          float32                   `json:"lambda"`
+	CriticalityBias map[string][3]float32     `json:"criticality_bias"`
+	PolicyOverrides map[string]PolicyOverride `json:"policy_overrides"`
+}
+
+// defaultPolicyConfig is what effectiveConfig falls back to before any update has ever been
+// applied: zero bias for every criticality, so collapseWaveFunctionBiased behaves exactly like
+// collapseWaveFunctionWith, and no per-policy overrides.
+func defaultPolicyConfig() *PolicyConfig {
+	return &PolicyConfig{
+		CriticalityBias: map[string][3]float32{},
+		PolicyOverrides: map[string]PolicyOverride{},
+	}
+}
+
+// effectiveConfig returns the currently active PolicyConfig, defaulting to
+// defaultPolicyConfig() if PolicyConfigWatcher has never successfully applied one.
+func (s *server) effectiveConfig() *PolicyConfig {
+	if cfg, ok := s.config.Load().(*PolicyConfig); ok && cfg != nil {
+		return cfg
+	}
+	return defaultPolicyConfig()
+}
+
+// criticalityBias resolves the additive weight adjustment collapseWaveFunctionBiased should
+// apply for a policy: its own per-policy override bias if one is configured, otherwise its
+// criticality's bias vector, otherwise nil (no adjustment, the pre-hot-reload behavior).
+func (s *server) criticalityBias(policyID, criticality string) *[3]float32 {
+	cfg := s.effectiveConfig()
+	if override, ok := cfg.PolicyOverrides[policyID]; ok && override.Bias != nil {
+		return override.Bias
+	}
+	if bias, ok := cfg.CriticalityBias[criticality]; ok {
+		return &bias
+	}
+	return nil
+}
+
+// effectiveLambda resolves the uncertainty λ a collapse or Bayesian-feedback update for
+// policyID should actually use: its own per-policy override if one is configured, otherwise
+// the current global λ from the last applied PolicyConfig reload, otherwise fallback (the
+// policy's own frozen UncertaintyParameter, i.e. the pre-hot-reload behavior for a policy that
+// predates any reload). Unlike criticalityBias, this does not fall through effectiveConfig's
+// zero-value default, since an empty PolicyConfig's Lambda of 0 would otherwise silently
+// override every already-registered policy's uncertainty down to 0 before any reload has ever
+// actually been applied.
+func (s *server) effectiveLambda(policyID string, fallback float32) float32 {
+	cfg, ok := s.config.Load().(*PolicyConfig)
+	if !ok || cfg == nil {
+		return fallback
+	}
+	if override, ok := cfg.PolicyOverrides[policyID]; ok && override.Lambda != nil {
+		return *override.Lambda
+	}
+	return cfg.Lambda
+}
+
+// validatePolicyConfig rejects a config document the same way SetUncertainty rejects a bad λ:
+// out-of-range lambdas, globally or in a per-policy override, are never applied.
+func validatePolicyConfig(cfg *PolicyConfig) error {
+	if cfg.Lambda < 0 || cfg.Lambda > 1 {
+		return fmt.Errorf("lambda must be between 0 and 1, got %v", cfg.Lambda)
+	}
+	for policyID, override := range cfg.PolicyOverrides {
+		if override.Lambda != nil && (*override.Lambda < 0 || *override.Lambda > 1) {
+			return fmt.Errorf("policy %s: lambda override must be between 0 and 1, got %v", policyID, *override.Lambda)
+		}
+	}
+	return nil
+}
+
+// applyConfig validates cfg, swaps it in atomically, mirrors its Lambda into the same
+// s.uncertainty field SetUncertainty maintains (so every reader of the global uncertainty
+// sees a hot-reload exactly as if an operator had called SetUncertainty), and emits a reload
+// metric. It leaves s.priorAlpha untouched; only SetUncertainty adjusts the Dirichlet prior.
+func (s *server) applyConfig(ctx context.Context, cfg *PolicyConfig) error {
+	if err := validatePolicyConfig(cfg); err != nil {
+		return err
+	}
+
+	s.config.Store(cfg)
+
+	s.uncertaintyMu.Lock()
+	s.uncertainty = cfg.Lambda
+	s.uncertaintyMu.Unlock()
+	uncertaintyLevel.Set(float64(cfg.Lambda))
+
+	configReloadsTotal.Inc()
+	s.eventHub.publish(ctx, &StateEvent{})
+
+	return nil
+}
+
+// policyConfigWatcher subscribes to configUpdatesChannel and hot-reloads the active
+// PolicyConfig, debouncing a burst of updates into a single reload.
+type policyConfigWatcher struct {
+	srv *server
+}
+
+func newPolicyConfigWatcher(srv *server) *policyConfigWatcher {
+	return &policyConfigWatcher{srv: srv}
+}
+
+// run subscribes to configUpdatesChannel and applies the most recent message seen within each
+// configDebounceWindow, until ctx is canceled.
+func (w *policyConfigWatcher) run(ctx context.Context) {
+	pubsub := w.srv.redisClient.Subscribe(ctx, configUpdatesChannel)
+	defer pubsub.Close()
+
+	debounce := time.NewTimer(configDebounceWindow)
+	if !debounce.Stop() {
+		<-debounce.C
+	}
+	defer debounce.Stop()
+
+	var pending *PolicyConfig
+	for {
+		select {
+		case <-ctx.Done():
+			return
+
+		case msg, ok := <-pubsub.Channel():
+			if !ok {
+				return
+			}
+			var cfg PolicyConfig
+			if err := json.Unmarshal([]byte(msg.Payload), &cfg); err != nil {
+				log.Printf("Policy config watcher: failed to unmarshal update: %v", err)
+				continue
+			}
+			pending = &cfg
+			debounce.Reset(configDebounceWindow)
+
+		case <-debounce.C:
+			if pending == nil {
+				continue
+			}
+			if err := w.srv.applyConfig(ctx, pending); err != nil {
+				log.Printf("Policy config watcher: rejected update: %v", err)
+			} else {
+				log.Printf("Policy config reloaded: lambda=%.3f, criticality_bias=%d, policy_overrides=%d",
+					pending.Lambda, len(pending.CriticalityBias), len(pending.PolicyOverrides))
+			}
+			pending = nil
+		}
+	}
+}
+
+// GetEffectiveConfig reports the PolicyConfig currently applied, so operators can confirm a
+// hot-reload took effect without reasoning about Redis state directly. If PolicyId is set and
+// has its own override, the response reflects that policy's effective lambda instead of the
+// global one.
+func (s *server) GetEffectiveConfig(ctx context.Context, req *pb.GetEffectiveConfigRequest) (*pb.GetEffectiveConfigResponse, error) {
+	cfg := s.effectiveConfig()
+
+	s.uncertaintyMu.RLock()
+	lambda := s.uncertainty
+	s.uncertaintyMu.RUnlock()
+
+	resp := &pb.GetEffectiveConfigResponse{
+		Lambda: lambda,
+	}
+	for criticality, bias := range cfg.CriticalityBias {
+		resp.CriticalityBias = append(resp.CriticalityBias, &pb.CriticalityBias{
+			Criticality:    criticality,
+			WeightApproved: bias[0],
+			WeightRejected: bias[1],
+			WeightPending:  bias[2],
+		})
+	}
+
+	if req.PolicyId != "" {
+		if override, ok := cfg.PolicyOverrides[req.PolicyId]; ok {
+			resp.PolicyOverrideApplied = true
+			if override.Lambda != nil {
+				resp.Lambda = *override.Lambda
+			}
+		}
+	}
+
+	return resp, nil
+}
@@ -0,0 +1,348 @@
+// Pluggable policy-evaluator backends for the Quantum Policy Evaluator.
+// Replaces the single hard-coded PGC HTTP client with a small plugin catalog keyed by URL
+// scheme, in the style of Vault's plugin catalog, so OPA, a gRPC PGC, a local CLI
+// evaluator, or a test double can all be swapped in without rebuilding the binary.
+
+package main
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"log"
+	"net/http"
+	"os/exec"
+	"strings"
+	"time"
+)
+
+// PolicyEvaluator abstracts over how a policy's PGC decision is obtained, so the collapse
+// path does not need to know whether it's talking to HTTP PGC, an OPA Data API, a gRPC PGC,
+// a local exec'd evaluator, or a mock.
+type PolicyEvaluator interface {
+	Evaluate(ctx context.Context, policy *QuantumPolicyInternal) (PGCResponse, error)
+}
+
+// evaluatorFactory constructs a PolicyEvaluator from the raw URL it was registered under.
+type evaluatorFactory func(rawURL string) (PolicyEvaluator, error)
+
+// evaluatorRegistry maps a URL scheme to the factory that builds a PolicyEvaluator for URLs
+// of that scheme.
+var evaluatorRegistry = map[string]evaluatorFactory{}
+
+func registerEvaluator(scheme string, factory evaluatorFactory) {
+	evaluatorRegistry[scheme] = factory
+}
+
+func init() {
+	registerEvaluator("http", newHTTPEvaluator)
+	registerEvaluator("https", newHTTPEvaluator)
+	registerEvaluator("opa+http", newOPAEvaluator)
+	registerEvaluator("exec", newExecEvaluator)
+	registerEvaluator("mock", newMockEvaluator)
+}
+
+// evaluatorURLs collects repeated -evaluator flag occurrences; main() passes it to
+// buildEvaluators at startup, and uses PGC_SERVICE_URL instead only when no -evaluator flag
+// was given.
+var evaluatorURLs stringSliceFlag
+
+func init() {
+	flag.Var(&evaluatorURLs, "evaluator", "policy evaluator URL (scheme selects the backend: http://, opa+http://, exec://, mock://); repeatable")
+}
+
+// stringSliceFlag accumulates every occurrence of a repeatable flag into a slice.
+type stringSliceFlag []string
+
+func (f *stringSliceFlag) String() string { return strings.Join(*f, ",") }
+
+func (f *stringSliceFlag) Set(value string) error {
+	*f = append(*f, value)
+	return nil
+}
+
+// buildEvaluators resolves each raw URL to a PolicyEvaluator via evaluatorRegistry, keyed
+// by URL scheme (the part before "://").
+func buildEvaluators(rawURLs []string) ([]PolicyEvaluator, error) {
+	evaluators := make([]PolicyEvaluator, 0, len(rawURLs))
+	for _, rawURL := range rawURLs {
+		scheme := rawURL
+		if idx := strings.Index(rawURL, "://"); idx >= 0 {
+			scheme = rawURL[:idx]
+		}
+
+		factory, ok := evaluatorRegistry[scheme]
+		if !ok {
+			return nil, fmt.Errorf("no policy evaluator registered for scheme %q (url %q)", scheme, rawURL)
+		}
+
+		evaluator, err := factory(rawURL)
+		if err != nil {
+			return nil, fmt.Errorf("failed to construct %q evaluator for %q: %w", scheme, rawURL, err)
+		}
+		evaluators = append(evaluators, evaluator)
+	}
+	return evaluators, nil
+}
+
+// AggregationStrategy controls how evaluatePolicy combines results from multiple
+// configured evaluators into the single PGCResponse the collapse path acts on.
+type AggregationStrategy string
+
+const (
+	// AggregationFirstSuccess returns the first evaluator response with Success == true,
+	// falling back to the first response received if none succeeded.
+	AggregationFirstSuccess AggregationStrategy = "first-success"
+	// AggregationMajorityVote picks the decision most evaluators agreed on.
+	AggregationMajorityVote AggregationStrategy = "majority-vote"
+	// AggregationWeightedQuorum picks the decision with the highest summed confidence,
+	// rather than a flat headcount.
+	AggregationWeightedQuorum AggregationStrategy = "weighted-quorum"
+)
+
+// evaluatePolicy fans a policy out to every configured evaluator and combines their
+// responses per s.aggregation. Its result is what downstream collapse weighting treats as
+// the real accuracy signal, in place of the former hard-coded 0.95 mock.
+func (s *server) evaluatePolicy(ctx context.Context, policy *QuantumPolicyInternal) (PGCResponse, error) {
+	if len(s.evaluators) == 0 {
+		return PGCResponse{}, fmt.Errorf("no policy evaluators configured")
+	}
+
+	responses := make([]PGCResponse, 0, len(s.evaluators))
+	var firstErr error
+	for _, evaluator := range s.evaluators {
+		res, err := evaluator.Evaluate(ctx, policy)
+		if err != nil {
+			log.Printf("Policy evaluator error: %v", err)
+			if firstErr == nil {
+				firstErr = err
+			}
+			continue
+		}
+		responses = append(responses, res)
+		if s.aggregation == AggregationFirstSuccess && res.Success {
+			return res, nil
+		}
+	}
+
+	if len(responses) == 0 {
+		if firstErr != nil {
+			return PGCResponse{}, firstErr
+		}
+		return PGCResponse{}, fmt.Errorf("all policy evaluators failed")
+	}
+
+	switch s.aggregation {
+	case AggregationMajorityVote:
+		return majorityVote(responses), nil
+	case AggregationWeightedQuorum:
+		return weightedQuorum(responses), nil
+	default: // AggregationFirstSuccess with no outright success
+		return responses[0], nil
+	}
+}
+
+// decisionOrder returns the distinct Decision values across responses in first-seen order,
+// so a tally keyed by decision can be walked deterministically instead of via Go's randomized
+// map iteration order.
+func decisionOrder(responses []PGCResponse) []string {
+	order := make([]string, 0, len(responses))
+	seen := make(map[string]bool, len(responses))
+	for _, r := range responses {
+		if !seen[r.Decision] {
+			seen[r.Decision] = true
+			order = append(order, r.Decision)
+		}
+	}
+	return order
+}
+
+// majorityVote picks the decision with the most votes across responses, averaging the
+// confidence of the responses that agreed with it. A tie is broken by whichever decision was
+// returned by the earliest evaluator in responses, so the outcome is reproducible instead of
+// depending on Go's randomized map iteration order.
+func majorityVote(responses []PGCResponse) PGCResponse {
+	votes := make(map[string]int)
+	confidenceSum := make(map[string]float32)
+	for _, r := range responses {
+		votes[r.Decision]++
+		confidenceSum[r.Decision] += r.Confidence
+	}
+
+	var winner string
+	var winnerVotes int
+	for _, decision := range decisionOrder(responses) {
+		if votes[decision] > winnerVotes {
+			winner = decision
+			winnerVotes = votes[decision]
+		}
+	}
+
+	return PGCResponse{
+		Success:    winner == "approved",
+		Decision:   winner,
+		Confidence: confidenceSum[winner] / float32(winnerVotes),
+		Reason:     fmt.Sprintf("majority vote: %d/%d evaluators agreed", winnerVotes, len(responses)),
+	}
+}
+
+// weightedQuorum picks the decision with the highest summed confidence across responses, so a
+// handful of highly-confident evaluators can outweigh a larger but unsure majority. A tie is
+// broken by whichever decision was returned by the earliest evaluator in responses, so the
+// outcome is reproducible instead of depending on Go's randomized map iteration order.
+func weightedQuorum(responses []PGCResponse) PGCResponse {
+	weight := make(map[string]float32)
+	count := make(map[string]int)
+	for _, r := range responses {
+		weight[r.Decision] += r.Confidence
+		count[r.Decision]++
+	}
+
+	var winner string
+	var winnerWeight float32
+	for _, decision := range decisionOrder(responses) {
+		if weight[decision] > winnerWeight {
+			winner = decision
+			winnerWeight = weight[decision]
+		}
+	}
+
+	return PGCResponse{
+		Success:    winner == "approved",
+		Decision:   winner,
+		Confidence: winnerWeight / float32(count[winner]),
+		Reason:     fmt.Sprintf("weighted quorum: cumulative confidence %.2f for %q", winnerWeight, winner),
+	}
+}
+
+// httpEvaluator is the original PGC integration: a POST /evaluate against an HTTP(S) PGC
+// service, enforcing a fixed request deadline.
+type httpEvaluator struct {
+	baseURL string
+	client  http.Client
+}
+
+func newHTTPEvaluator(rawURL string) (PolicyEvaluator, error) {
+	return &httpEvaluator{baseURL: rawURL, client: http.Client{Timeout: 2 * time.Second}}, nil
+}
+
+func (e *httpEvaluator) Evaluate(ctx context.Context, policy *QuantumPolicyInternal) (PGCResponse, error) {
+	payload, err := json.Marshal(policy)
+	if err != nil {
+		return PGCResponse{}, fmt.Errorf("failed to marshal policy for PGC: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, e.baseURL+"/evaluate", bytes.NewReader(payload))
+	if err != nil {
+		return PGCResponse{}, fmt.Errorf("failed to construct PGC request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := e.client.Do(req)
+	if err != nil {
+		return PGCResponse{}, fmt.Errorf("PGC HTTP request failed: %w", err)
+	}
+	defer resp.Body.Close()
+
+	var res PGCResponse
+	if err := json.NewDecoder(resp.Body).Decode(&res); err != nil {
+		return PGCResponse{}, fmt.Errorf("failed to decode PGC response: %w", err)
+	}
+	return res, nil
+}
+
+// opaEvaluator queries an OPA instance's HTTP Data API directly, bypassing the PGC HTTP
+// contract entirely. rawURL looks like "opa+http://host:port/v1/data/<policy/path>".
+type opaEvaluator struct {
+	queryURL string
+	client   http.Client
+}
+
+func newOPAEvaluator(rawURL string) (PolicyEvaluator, error) {
+	queryURL := "http" + strings.TrimPrefix(rawURL, "opa+http")
+	return &opaEvaluator{queryURL: queryURL, client: http.Client{Timeout: 2 * time.Second}}, nil
+}
+
+func (e *opaEvaluator) Evaluate(ctx context.Context, policy *QuantumPolicyInternal) (PGCResponse, error) {
+	body, err := json.Marshal(map[string]interface{}{"input": policy})
+	if err != nil {
+		return PGCResponse{}, fmt.Errorf("failed to marshal OPA input: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, e.queryURL, bytes.NewReader(body))
+	if err != nil {
+		return PGCResponse{}, fmt.Errorf("failed to construct OPA request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := e.client.Do(req)
+	if err != nil {
+		return PGCResponse{}, fmt.Errorf("OPA query failed: %w", err)
+	}
+	defer resp.Body.Close()
+
+	var decoded struct {
+		Result PGCResponse `json:"result"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&decoded); err != nil {
+		return PGCResponse{}, fmt.Errorf("failed to decode OPA response: %w", err)
+	}
+	return decoded.Result, nil
+}
+
+// execEvaluator shells out to a local binary for policies evaluated by a CLI tool rather
+// than a network service. rawURL is "exec:///path/to/binary"; the policy is marshaled to
+// the process's stdin and a PGCResponse is decoded from its stdout.
+type execEvaluator struct {
+	path string
+}
+
+func newExecEvaluator(rawURL string) (PolicyEvaluator, error) {
+	path := strings.TrimPrefix(rawURL, "exec://")
+	if path == "" {
+		return nil, fmt.Errorf("exec evaluator requires a binary path, got %q", rawURL)
+	}
+	return &execEvaluator{path: path}, nil
+}
+
+func (e *execEvaluator) Evaluate(ctx context.Context, policy *QuantumPolicyInternal) (PGCResponse, error) {
+	payload, err := json.Marshal(policy)
+	if err != nil {
+		return PGCResponse{}, fmt.Errorf("failed to marshal policy for exec evaluator: %w", err)
+	}
+
+	cmd := exec.CommandContext(ctx, e.path)
+	cmd.Stdin = bytes.NewReader(payload)
+	out, err := cmd.Output()
+	if err != nil {
+		return PGCResponse{}, fmt.Errorf("exec evaluator %q failed: %w", e.path, err)
+	}
+
+	var res PGCResponse
+	if err := json.Unmarshal(out, &res); err != nil {
+		return PGCResponse{}, fmt.Errorf("failed to decode exec evaluator output: %w", err)
+	}
+	return res, nil
+}
+
+// A gRPC PGC backend (scheme "grpc://") is intentionally not registered: there is no vendored
+// PGC gRPC client stub in this tree to evaluate against, and advertising the scheme without one
+// would let an operator configure a backend that dials successfully at startup but fails every
+// single evaluation. Add a "grpc" registration here once a real PGC gRPC client is vendored.
+
+// mockEvaluator is a configurable test double registered under scheme "mock://". It lets
+// tests and local dev exercise the full evaluator/aggregation path without a live PGC.
+type mockEvaluator struct {
+	decision   string
+	confidence float32
+}
+
+func newMockEvaluator(rawURL string) (PolicyEvaluator, error) {
+	return &mockEvaluator{decision: "approved", confidence: 0.95}, nil
+}
+
+func (e *mockEvaluator) Evaluate(ctx context.Context, policy *QuantumPolicyInternal) (PGCResponse, error) {
+	return PGCResponse{Success: true, Decision: e.decision, Confidence: e.confidence, Reason: "mock evaluator"}, nil
+}
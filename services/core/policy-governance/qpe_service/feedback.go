@@ -0,0 +1,136 @@
+// Bayesian weight updates for the Quantum Policy Evaluator.
+// Before this file, the three superposition weights were only ever read, not written, until
+// collapse; the PGC response was reduced to a single pgc_result bool. This folds each
+// evaluator observation into the weights themselves: WeightApproved/Rejected/Pending are
+// treated as components of a Dirichlet distribution that gets updated toward whichever
+// component the evaluator (or an operator, via RecordFeedback) observed, decaying the
+// previous weights by the policy's effective uncertainty λ (see server.effectiveLambda) so the equal-superposition prior
+// fades in proportion to how much evidence has accumulated.
+
+package main
+
+import (
+	"context"
+	"log"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	pb "qpe_service/proto"
+)
+
+// decisionToWeightIndex maps a PGCResponse.Decision (or RecordFeedback outcome) string onto
+// the WeightApproved/WeightRejected/WeightPending ordering used everywhere else in this
+// package, so index i always lines up with pb.State(i).
+func decisionToWeightIndex(decision string) (int, bool) {
+	switch decision {
+	case "approved":
+		return 0, true
+	case "rejected":
+		return 1, true
+	case "pending":
+		return 2, true
+	default:
+		return 0, false
+	}
+}
+
+// normalizeWeights rescales w so its components sum to 1, preserving their relative
+// proportions. A zero-sum vector falls back to equal superposition rather than dividing by
+// zero.
+func normalizeWeights(w [3]float32) [3]float32 {
+	sum := w[0] + w[1] + w[2]
+	if sum <= 0 {
+		return [3]float32{defaultWeightApproved, defaultWeightRejected, defaultWeightPending}
+	}
+	return [3]float32{w[0] / sum, w[1] / sum, w[2] / sum}
+}
+
+// applyBayesianFeedback folds one evaluator observation into policy's superposition weights.
+// The current weights are blended with priorAlpha by decay (decay=1 lets the current weights
+// stand entirely; decay=0 resets them fully to the prior), confidence is then added as a
+// pseudo-count to the observed component, and the result is renormalized so the sum-to-1
+// invariant holds. Already-collapsed policies are returned unchanged, since their weights no
+// longer mean anything once a state is fixed.
+func applyBayesianFeedback(policy *QuantumPolicyInternal, priorAlpha [3]float32, observedIndex int, confidence, decay float32) *QuantumPolicyInternal {
+	next := *policy
+	if next.IsCollapsed {
+		return &next
+	}
+
+	cur := [3]float32{next.WeightApproved, next.WeightRejected, next.WeightPending}
+	var alpha [3]float32
+	for i := range alpha {
+		alpha[i] = decay*cur[i] + (1-decay)*priorAlpha[i]
+	}
+	alpha[observedIndex] += confidence
+
+	weights := normalizeWeights(alpha)
+	next.WeightApproved = weights[0]
+	next.WeightRejected = weights[1]
+	next.WeightPending = weights[2]
+
+	return &next
+}
+
+// RecordFeedback folds a PGC-style decision/confidence observation into a policy's
+// superposition weights via applyBayesianFeedback, without collapsing it. This is the manual
+// counterpart to the automatic update Measure applies from its own evaluator call, for
+// callers that observe outcomes out of band (a human reviewer, an async PGC callback).
+func (s *server) RecordFeedback(ctx context.Context, req *pb.FeedbackRequest) (*pb.FeedbackResponse, error) {
+	if req.PolicyId == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "policy_id cannot be empty")
+	}
+	if req.Confidence < 0 || req.Confidence > 1 {
+		return nil, status.Errorf(codes.InvalidArgument, "confidence must be between 0 and 1")
+	}
+	observedIndex, ok := decisionToWeightIndex(req.Outcome)
+	if !ok {
+		return nil, status.Errorf(codes.InvalidArgument, "unrecognized outcome %q", req.Outcome)
+	}
+
+	policy, err := s.getPolicy(ctx, req.PolicyId)
+	if err != nil {
+		return nil, err
+	}
+	if policy.IsCollapsed {
+		return nil, status.Errorf(codes.FailedPrecondition, "policy %s has already collapsed; feedback no longer affects its weights", req.PolicyId)
+	}
+
+	s.uncertaintyMu.RLock()
+	priorAlpha := s.priorAlpha
+	s.uncertaintyMu.RUnlock()
+
+	var weightsBefore [3]float32
+	updated, err := s.updatePolicy(ctx, req.PolicyId, func(cur *QuantumPolicyInternal) (*QuantumPolicyInternal, error) {
+		if cur.IsCollapsed {
+			return nil, errNoUpdateNeeded
+		}
+		weightsBefore = [3]float32{cur.WeightApproved, cur.WeightRejected, cur.WeightPending}
+		decay := s.effectiveLambda(cur.PolicyID, cur.UncertaintyParameter)
+		return applyBayesianFeedback(cur, priorAlpha, observedIndex, req.Confidence, decay), nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if !updated.IsCollapsed {
+		s.eventHub.publish(ctx, &StateEvent{
+			PolicyID:        updated.PolicyID,
+			EntanglementTag: updated.EntanglementTag,
+			WeightsBefore:   weightsBefore,
+			WeightsAfter:    [3]float32{updated.WeightApproved, updated.WeightRejected, updated.WeightPending},
+			State:           updated.CollapsedState,
+		})
+	}
+
+	log.Printf("QPE feedback: policy=%s, outcome=%s, confidence=%.2f, weights=[%.3f %.3f %.3f]",
+		req.PolicyId, req.Outcome, req.Confidence, updated.WeightApproved, updated.WeightRejected, updated.WeightPending)
+
+	return &pb.FeedbackResponse{
+		PolicyId:       req.PolicyId,
+		WeightApproved: updated.WeightApproved,
+		WeightRejected: updated.WeightRejected,
+		WeightPending:  updated.WeightPending,
+	}, nil
+}
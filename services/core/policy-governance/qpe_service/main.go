@@ -12,20 +12,25 @@
 package main
 
 import (
-	"bytes"
 	"context"
 	"crypto/hmac"
 	"crypto/rand"
 	"crypto/sha256"
 	"encoding/json"
+	"errors"
+	"flag"
 	"fmt"
 	"log"
 	"math"
+	mathrand "math/rand"
 	"net"
 	"net/http"
 	"os"
 	"os/signal"
+	"strconv"
+	"strings"
 	"sync"
+	"sync/atomic"
 	"syscall"
 	"time"
 
@@ -37,6 +42,7 @@ import (
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
+	"qpe_service/cluster"
 	pb "qpe_service/proto"
 )
 
@@ -56,8 +62,16 @@ const (
 	// Redis key prefixes
 	policyKeyPrefix  = "qpe:policy:"
 	metricsKeyPrefix = "qpe:metrics:"
+
+	// maxCASRetries bounds the optimistic-concurrency retry loop in updatePolicy.
+	maxCASRetries = 5
 )
 
+// errNoUpdateNeeded is returned by a tryUpdate closure to tell updatePolicy that the
+// current record already reflects the caller's intent, so it should be returned as-is
+// without a write (e.g. a collapse request racing a second collapse to the same state).
+var errNoUpdateNeeded = errors.New("qpe: no update needed")
+
 // Prometheus metrics
 var (
 	qpeLatency = promauto.NewHistogramVec(
@@ -97,6 +111,14 @@ var (
 			Help: "Number of policies currently in quantum superposition",
 		},
 	)
+
+	casConflicts = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "qpe_cas_conflicts_total",
+			Help: "Total number of optimistic concurrency conflicts encountered while CAS-updating policy state",
+		},
+		[]string{"policy_id"},
+	)
 )
 
 // QPE Server implementation
@@ -104,10 +126,15 @@ type server struct {
 	pb.UnimplementedQuantumPolicyEvaluatorServer
 	redisClient       *redis.Client
 	uncertainty       float32
+	priorAlpha        [3]float32 // Dirichlet prior for Bayesian weight updates; guarded by uncertaintyMu
 	uncertaintyMu     sync.RWMutex
-	pgcServiceURL     string
+	evaluators        []PolicyEvaluator
+	aggregation       AggregationStrategy
 	deterministicMode bool
 	metrics           *QPEMetrics
+	eventHub          *eventHub
+	cluster           *cluster.Coordinator // nil unless CLUSTER_PEERS/CLUSTER_QUORUM_SIZE configure quorum-signed collapse
+	config            atomic.Value         // holds *PolicyConfig; see config.go and effectiveConfig
 }
 
 // QPE Metrics for monitoring
@@ -146,30 +173,6 @@ type PGCResponse struct {
 	Error      string  `json:"error,omitempty"`
 }
 
-// callPGCService issues a POST request with the serialized policy payload, enforcing context deadlines and interpreting the PGC response.
-func callPGCService(ctx context.Context, baseURL string, policy *QuantumPolicyInternal) (bool, error) {
-	client := http.Client{Timeout: 2 * time.Second}
-	payload, err := json.Marshal(policy)
-	if err != nil {
-		return false, fmt.Errorf("failed to marshal policy for PGC: %w", err)
-	}
-	req, err := http.NewRequestWithContext(ctx, http.MethodPost, baseURL+"/evaluate", bytes.NewReader(payload))
-	if err != nil {
-		return false, fmt.Errorf("failed to construct PGC request: %w", err)
-	}
-	req.Header.Set("Content-Type", "application/json")
-	resp, err := client.Do(req)
-	if err != nil {
-		return false, fmt.Errorf("PGC HTTP request failed: %w", err)
-	}
-	defer resp.Body.Close()
-	var res PGCResponse
-	if err := json.NewDecoder(resp.Body).Decode(&res); err != nil {
-		return false, fmt.Errorf("failed to decode PGC response: %w", err)
-	}
-	return res.Success, nil
-}
-
 // Generate entanglement tag using HMAC-SHA256
 func generateEntanglementTag(policyID string) []byte {
 	h := hmac.New(sha256.New, []byte(constitutionalHash))
@@ -210,6 +213,21 @@ func probabilisticCollapse(weights []float32) pb.State {
 	randValue := float32(uint32(randBytes[0])<<24|uint32(randBytes[1])<<16|
 		uint32(randBytes[2])<<8|uint32(randBytes[3])) / float32(1<<32)
 
+	return pickWeightedState(weights, randValue)
+}
+
+// probabilisticCollapseSeeded is probabilisticCollapse driven by a seeded PRNG instead of
+// crypto/rand, so cluster quorum peers sharing the same seed (see the cluster package)
+// independently derive the identical outcome.
+func probabilisticCollapseSeeded(weights []float32, seed int64) pb.State {
+	rng := mathrand.New(mathrand.NewSource(seed))
+	return pickWeightedState(weights, rng.Float32())
+}
+
+// pickWeightedState walks weights in order and returns the index whose cumulative share
+// first exceeds randValue (a uniform draw in [0,1)); it falls back to PENDING, which should
+// not happen with normalized weights.
+func pickWeightedState(weights []float32, randValue float32) pb.State {
 	cumulative := float32(0.0)
 	for i, weight := range weights {
 		cumulative += weight
@@ -217,8 +235,6 @@ func probabilisticCollapse(weights []float32) pb.State {
 			return pb.State(i)
 		}
 	}
-
-	// Fallback (should not happen with normalized weights)
 	return pb.State_PENDING
 }
 
@@ -271,6 +287,15 @@ func (s *server) Register(ctx context.Context, req *pb.RegisterRequest) (*pb.Reg
 		return nil, status.Errorf(codes.Internal, "failed to store policy: %v", err)
 	}
 
+	// Track the deadline so the background reaper can collapse this policy even if no one
+	// ever calls Measure/Observe on it.
+	if err := s.redisClient.ZAdd(ctx, deadlinesKey, &redis.Z{
+		Score:  float64(policy.DeadlineAt),
+		Member: policy.PolicyID,
+	}).Err(); err != nil {
+		log.Printf("Failed to schedule deadline reaper entry for %s: %v", policy.PolicyID, err)
+	}
+
 	// Update metrics
 	s.metrics.mu.Lock()
 	s.metrics.TotalPolicies++
@@ -279,6 +304,15 @@ func (s *server) Register(ctx context.Context, req *pb.RegisterRequest) (*pb.Reg
 
 	policiesInSuperposition.Inc()
 
+	weights := [3]float32{policy.WeightApproved, policy.WeightRejected, policy.WeightPending}
+	s.eventHub.publish(ctx, &StateEvent{
+		PolicyID:        policy.PolicyID,
+		EntanglementTag: policy.EntanglementTag,
+		WeightsBefore:   weights,
+		WeightsAfter:    weights,
+		State:           policy.CollapsedState,
+	})
+
 	// Convert to protobuf response
 	pbPolicy := &pb.QuantumPolicy{
 		PolicyId:             policy.PolicyID,
@@ -325,20 +359,88 @@ func (s *server) getPolicy(ctx context.Context, policyID string) (*QuantumPolicy
 	return &policy, nil
 }
 
-// Update policy in Redis
-func (s *server) updatePolicy(ctx context.Context, policy *QuantumPolicyInternal) error {
-	policyJSON, err := json.Marshal(policy)
-	if err != nil {
-		return fmt.Errorf("failed to marshal policy: %v", err)
-	}
+// updatePolicy performs an optimistic compare-and-swap update of a policy record, modeled
+// on the etcd3 storage pattern: WATCH the key, let tryUpdate compute the next value from a
+// private copy of the current one, then MULTI/EXEC a SET that the Redis client aborts if the
+// watched key changed underneath us. On a conflict we retry with jittered backoff up to
+// maxCASRetries. tryUpdate may return (nil, errNoUpdateNeeded) to short-circuit when cur
+// already satisfies the caller's intent, avoiding a redundant write.
+func (s *server) updatePolicy(ctx context.Context, policyID string, tryUpdate func(cur *QuantumPolicyInternal) (*QuantumPolicyInternal, error)) (*QuantumPolicyInternal, error) {
+	key := policyKeyPrefix + policyID
 
-	key := policyKeyPrefix + policy.PolicyID
-	err = s.redisClient.Set(ctx, key, policyJSON, 0).Err()
-	if err != nil {
-		return fmt.Errorf("failed to update policy: %v", err)
+	for attempt := 0; attempt < maxCASRetries; attempt++ {
+		var result *QuantumPolicyInternal
+
+		txErr := s.redisClient.Watch(ctx, func(tx *redis.Tx) error {
+			policyJSON, err := tx.Get(ctx, key).Result()
+			if err != nil {
+				return err
+			}
+
+			var cur QuantumPolicyInternal
+			if err := json.Unmarshal([]byte(policyJSON), &cur); err != nil {
+				return fmt.Errorf("failed to unmarshal policy: %w", err)
+			}
+
+			next, err := tryUpdate(&cur)
+			if errors.Is(err, errNoUpdateNeeded) {
+				result = &cur
+				return nil
+			}
+			if err != nil {
+				return err
+			}
+
+			nextJSON, err := json.Marshal(next)
+			if err != nil {
+				return fmt.Errorf("failed to marshal policy: %w", err)
+			}
+
+			_, err = tx.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+				pipe.Set(ctx, key, nextJSON, 0)
+				return nil
+			})
+			if err != nil {
+				return err
+			}
+
+			result = next
+			return nil
+		}, key)
+
+		switch {
+		case txErr == nil:
+			return result, nil
+		case txErr == redis.TxFailedErr:
+			casConflicts.WithLabelValues(policyID).Inc()
+			backoff := time.Duration(attempt+1)*5*time.Millisecond + time.Duration(mathrand.Intn(5))*time.Millisecond
+			time.Sleep(backoff)
+			continue
+		case txErr == redis.Nil:
+			return nil, status.Errorf(codes.NotFound, "policy not found: %s", policyID)
+		default:
+			return nil, status.Errorf(codes.Internal, "failed to update policy: %v", txErr)
+		}
 	}
 
-	return nil
+	return nil, status.Errorf(codes.Aborted, "exceeded %d CAS retry attempts updating policy %s", maxCASRetries, policyID)
+}
+
+// determineCollapseReason picks the CollapseReason a fresh collapse of policy would be
+// attributed to: deadline expiry takes priority over deterministic mode, which takes priority
+// over a plain measurement trigger. An already-collapsed policy reports MEASUREMENT too, since
+// collapseWaveFunction is a no-op on it regardless of reason.
+func (s *server) determineCollapseReason(policy *QuantumPolicyInternal) pb.CollapseReason {
+	switch {
+	case policy.IsCollapsed:
+		return pb.CollapseReason_MEASUREMENT
+	case s.checkDeadlineExpired(policy):
+		return pb.CollapseReason_DEADLINE_EXPIRED
+	case s.deterministicMode:
+		return pb.CollapseReason_DETERMINISTIC
+	default:
+		return pb.CollapseReason_MEASUREMENT
+	}
 }
 
 // Check if policy deadline has expired
@@ -346,10 +448,41 @@ func (s *server) checkDeadlineExpired(policy *QuantumPolicyInternal) bool {
 	return time.Now().Unix() > policy.DeadlineAt
 }
 
-// Collapse wave function and determine final state
-func (s *server) collapseWaveFunction(policy *QuantumPolicyInternal, reason pb.CollapseReason) pb.State {
-	if policy.IsCollapsed {
-		return policy.CollapsedState
+// collapseWaveFunction computes the state a policy collapses to for the given reason and
+// returns a new copy of policy with IsCollapsed/CollapsedState applied (or an unchanged copy
+// if it was already collapsed). It is free of side effects so a CAS retry loop in
+// updatePolicy can invoke it any number of times without double-counting metrics; callers
+// must call recordCollapseMetrics themselves once a collapse actually commits.
+func collapseWaveFunction(policy *QuantumPolicyInternal, reason pb.CollapseReason) *QuantumPolicyInternal {
+	return collapseWaveFunctionWith(policy, reason, probabilisticCollapse)
+}
+
+// collapseWaveFunctionWith is collapseWaveFunction parameterized by the probabilistic-choice
+// function, so a cluster quorum round (see the cluster package and quorum.go) can substitute
+// probabilisticCollapseSeeded and have every peer derive the same outcome from a shared seed
+// instead of crypto/rand's per-process entropy.
+func collapseWaveFunctionWith(policy *QuantumPolicyInternal, reason pb.CollapseReason, probChoice func([]float32) pb.State) *QuantumPolicyInternal {
+	return collapseWaveFunctionBiased(policy, reason, probChoice, nil, policy.UncertaintyParameter)
+}
+
+// collapseWaveFunctionBiased is collapseWaveFunctionWith with an optional additive bias
+// ([approved, rejected, pending] adjustment from PolicyConfigWatcher, see config.go) blended
+// into the weights a probabilistic branch sees, renormalized via normalizeWeights, and an
+// explicit uncertainty λ for the HIGH-criticality branch below instead of always reading
+// policy.UncertaintyParameter directly. A nil bias and lambda == policy.UncertaintyParameter
+// behave exactly like collapseWaveFunctionWith, so every existing caller is unaffected until an
+// operator actually pushes a criticality/λ override (see server.criticalityBias,
+// server.effectiveLambda).
+func collapseWaveFunctionBiased(policy *QuantumPolicyInternal, reason pb.CollapseReason, probChoice func([]float32) pb.State, bias *[3]float32, lambda float32) *QuantumPolicyInternal {
+	next := *policy
+	if next.IsCollapsed {
+		return &next
+	}
+
+	rawWeights := [3]float32{policy.WeightApproved, policy.WeightRejected, policy.WeightPending}
+	biasedWeights := rawWeights
+	if bias != nil {
+		biasedWeights = normalizeWeights([3]float32{rawWeights[0] + bias[0], rawWeights[1] + bias[1], rawWeights[2] + bias[2]})
 	}
 
 	var finalState pb.State
@@ -357,8 +490,10 @@ func (s *server) collapseWaveFunction(policy *QuantumPolicyInternal, reason pb.C
 	// Apply collapse rules based on reason and policy properties
 	switch reason {
 	case pb.CollapseReason_DEADLINE_EXPIRED:
-		// Use maximum weight component for deadline collapse
-		weights := []float32{policy.WeightApproved, policy.WeightRejected, policy.WeightPending}
+		// Use maximum weight component for deadline collapse; deliberately unbiased, since a
+		// deadline collapse should reflect the evidence actually gathered, not an operator's
+		// criticality tuning.
+		weights := []float32{rawWeights[0], rawWeights[1], rawWeights[2]}
 		maxWeight := float32(0.0)
 		maxIndex := 0
 		for i, w := range weights {
@@ -374,23 +509,39 @@ func (s *server) collapseWaveFunction(policy *QuantumPolicyInternal, reason pb.C
 
 	case pb.CollapseReason_OBSERVATION:
 		// High criticality policies bias toward pending for human review
-		if policy.Criticality == "HIGH" && policy.UncertaintyParameter > 0.7 {
+		if policy.Criticality == "HIGH" && lambda > 0.7 {
 			finalState = pb.State_PENDING
 		} else {
-			weights := []float32{policy.WeightApproved, policy.WeightRejected, policy.WeightPending}
-			finalState = probabilisticCollapse(weights)
+			finalState = probChoice([]float32{biasedWeights[0], biasedWeights[1], biasedWeights[2]})
 		}
 
 	default: // MEASUREMENT, MANUAL
-		weights := []float32{policy.WeightApproved, policy.WeightRejected, policy.WeightPending}
-		finalState = probabilisticCollapse(weights)
+		finalState = probChoice([]float32{biasedWeights[0], biasedWeights[1], biasedWeights[2]})
 	}
 
-	// Update policy state
-	policy.IsCollapsed = true
-	policy.CollapsedState = finalState
+	next.IsCollapsed = true
+	next.CollapsedState = finalState
 
-	// Update metrics
+	return &next
+}
+
+// collapseToState returns a copy of policy collapsed to exactly the given state, bypassing
+// the usual per-reason derivation in collapseWaveFunction. Used once a cluster quorum round
+// has already decided the outcome, so every CAS retry of the same updatePolicy call commits
+// that agreed state rather than re-deriving a potentially different one.
+func collapseToState(policy *QuantumPolicyInternal, state pb.State) *QuantumPolicyInternal {
+	next := *policy
+	if next.IsCollapsed {
+		return &next
+	}
+	next.IsCollapsed = true
+	next.CollapsedState = state
+	return &next
+}
+
+// recordCollapseMetrics updates the service's superposition/collapse bookkeeping once a
+// collapse computed by collapseWaveFunction has actually been committed via updatePolicy.
+func (s *server) recordCollapseMetrics(policy *QuantumPolicyInternal, reason pb.CollapseReason) {
 	s.metrics.mu.Lock()
 	s.metrics.PoliciesInSuperposition--
 	s.metrics.CollapsedPolicies++
@@ -401,9 +552,7 @@ func (s *server) collapseWaveFunction(policy *QuantumPolicyInternal, reason pb.C
 	s.metrics.mu.Unlock()
 
 	policiesInSuperposition.Dec()
-	stateTransitions.WithLabelValues(policy.PolicyID, "SUPERPOSITION", finalState.String()).Inc()
-
-	return finalState
+	stateTransitions.WithLabelValues(policy.PolicyID, "SUPERPOSITION", policy.CollapsedState.String()).Inc()
 }
 
 // Measure policy state (collapses superposition and calls PGC)
@@ -421,44 +570,111 @@ func (s *server) Measure(ctx context.Context, req *pb.MeasureRequest) (*pb.Measu
 		return nil, status.Errorf(codes.DataLoss, "entanglement tag verification failed")
 	}
 
-	var finalState pb.State
-	var collapseReason pb.CollapseReason
 	wasAlreadyCollapsed := policy.IsCollapsed
-
-	// Check if already collapsed
-	if policy.IsCollapsed {
-		finalState = policy.CollapsedState
-		collapseReason = pb.CollapseReason_MEASUREMENT
+	weightsBefore := [3]float32{policy.WeightApproved, policy.WeightRejected, policy.WeightPending}
+
+	// Determine the reason a fresh collapse would be attributed to; re-checked against the
+	// CAS-fresh record inside tryUpdate since it may have changed since getPolicy above.
+	collapseReason := s.determineCollapseReason(policy)
+
+	var pgcResponse PGCResponse
+	var agreedState *pb.State
+
+	if req.CommitmentToken != "" && !wasAlreadyCollapsed {
+		// Two-phase commit: a prior PrepareMeasure call (see prepare.go) already decided the
+		// exact outcome this token stands for, so commit precisely that instead of calling the
+		// evaluator and recomputing one.
+		commitment, commitErr := s.consumeCommitment(ctx, req.PolicyId, req.CommitmentToken)
+		if commitErr != nil {
+			return nil, commitErr
+		}
+		state := commitment.State
+		agreedState = &state
+		collapseReason = commitment.Reason
 	} else {
-		// Check for deadline expiration
-		if s.checkDeadlineExpired(policy) {
-			collapseReason = pb.CollapseReason_DEADLINE_EXPIRED
-		} else if s.deterministicMode {
-			collapseReason = pb.CollapseReason_DETERMINISTIC
-		} else {
-			collapseReason = pb.CollapseReason_MEASUREMENT
+		// Integrate with the configured policy evaluator(s), fanning out and aggregating per
+		// s.aggregation when more than one is registered. This happens before collapse so a
+		// non-deterministic collapse draws on weights the evaluator's decision/confidence has
+		// already nudged via applyBayesianFeedback, rather than only the static prior.
+		var evalErr error
+		pgcResponse, evalErr = s.evaluatePolicy(ctx, policy)
+		if evalErr != nil {
+			log.Printf("Policy evaluator error: %v", evalErr)
 		}
 
-		// Collapse wave function
-		finalState = s.collapseWaveFunction(policy, collapseReason)
+		if !wasAlreadyCollapsed {
+			if observedIndex, ok := decisionToWeightIndex(pgcResponse.Decision); ok {
+				s.uncertaintyMu.RLock()
+				priorAlpha := s.priorAlpha
+				s.uncertaintyMu.RUnlock()
+
+				updatedPolicy, feedbackErr := s.updatePolicy(ctx, req.PolicyId, func(cur *QuantumPolicyInternal) (*QuantumPolicyInternal, error) {
+					if cur.IsCollapsed {
+						return nil, errNoUpdateNeeded
+					}
+					decay := s.effectiveLambda(cur.PolicyID, cur.UncertaintyParameter)
+					return applyBayesianFeedback(cur, priorAlpha, observedIndex, pgcResponse.Confidence, decay), nil
+				})
+				if feedbackErr != nil {
+					log.Printf("Failed to apply Bayesian feedback for %s: %v", req.PolicyId, feedbackErr)
+				} else {
+					// Carry the post-feedback weights forward so the quorum round below (and a
+					// non-clustered collapse further down) draws on the evidence this update just
+					// persisted, instead of the pre-feedback snapshot read at the top of Measure.
+					policy = updatedPolicy
+				}
+			}
+		}
 
-		// Update policy in Redis
-		err = s.updatePolicy(ctx, policy)
-		if err != nil {
-			log.Printf("Failed to update policy after collapse: %v", err)
+		// In cluster mode this runs a quorum round before anything is written: agreedState is
+		// non-nil only once enough peers independently recomputed the same outcome, and a
+		// disagreement surfaces as collapseReason == QUORUM_MISMATCH instead of committing a
+		// disputed state (see resolveCollapse). With no cluster configured this is a no-op and
+		// collapseReason passes through unchanged.
+		if !wasAlreadyCollapsed {
+			agreedState, collapseReason = s.resolveCollapse(ctx, policy, collapseReason)
 		}
 	}
 
-	// Integrate with external PGC service via HTTP helper
-	pgcResult, err := callPGCService(ctx, s.pgcServiceURL, policy)
+	updated, err := s.updatePolicy(ctx, req.PolicyId, func(cur *QuantumPolicyInternal) (*QuantumPolicyInternal, error) {
+		if cur.IsCollapsed || collapseReason == pb.CollapseReason_QUORUM_MISMATCH {
+			return nil, errNoUpdateNeeded
+		}
+		if agreedState != nil {
+			return collapseToState(cur, *agreedState), nil
+		}
+		collapseReason = s.determineCollapseReason(cur)
+		bias := s.criticalityBias(cur.PolicyID, cur.Criticality)
+		lambda := s.effectiveLambda(cur.PolicyID, cur.UncertaintyParameter)
+		return collapseWaveFunctionBiased(cur, collapseReason, probabilisticCollapse, bias, lambda), nil
+	})
 	if err != nil {
-		log.Printf("PGC invocation error: %v", err)
-		pgcResult = false
+		return nil, err
+	}
+	policy = updated
+	finalState := policy.CollapsedState
+
+	if !wasAlreadyCollapsed && policy.IsCollapsed {
+		s.recordCollapseMetrics(policy, collapseReason)
+		s.eventHub.publish(ctx, &StateEvent{
+			PolicyID:        policy.PolicyID,
+			EntanglementTag: policy.EntanglementTag,
+			WeightsBefore:   weightsBefore,
+			WeightsAfter:    [3]float32{policy.WeightApproved, policy.WeightRejected, policy.WeightPending},
+			State:           finalState,
+			CollapseReason:  collapseReason,
+		})
 	}
 
-	// Calculate Heisenberg constant (latency × accuracy)
+	pgcResult := pgcResponse.Success
+
+	// Calculate Heisenberg constant (latency × accuracy); accuracy now reflects the
+	// aggregated evaluator confidence instead of a hard-coded mock
 	latencyMs := float32(time.Since(startTime).Milliseconds())
-	accuracy := float32(0.95) // Mock accuracy - calculate from PGC result
+	accuracy := pgcResponse.Confidence
+	if accuracy == 0 {
+		accuracy = 0.95 // fallback when no evaluator returned a confidence (e.g. all failed)
+	}
 	heisenbergK := latencyMs * accuracy
 
 	// Update metrics
@@ -480,18 +696,42 @@ func (s *server) Measure(ctx context.Context, req *pb.MeasureRequest) (*pb.Measu
 	}, nil
 }
 
-// Set uncertainty parameter (λ) for speed-accuracy trade-off
+// Set uncertainty parameter (λ) for speed-accuracy trade-off, and optionally the Dirichlet
+// prior α vector applyBayesianFeedback blends new evidence against (3 components: approved,
+// rejected, pending).
 func (s *server) SetUncertainty(ctx context.Context, req *pb.UncertaintyRequest) (*pb.UncertaintyResponse, error) {
 	if req.Lambda < 0 || req.Lambda > 1 {
 		return nil, status.Errorf(codes.InvalidArgument, "lambda must be between 0 and 1")
 	}
 
+	var priorAlpha [3]float32
+	setPrior := len(req.PriorAlpha) > 0
+	if setPrior {
+		if len(req.PriorAlpha) != 3 {
+			return nil, status.Errorf(codes.InvalidArgument, "prior_alpha must have exactly 3 components (approved, rejected, pending)")
+		}
+		for _, a := range req.PriorAlpha {
+			if a <= 0 {
+				return nil, status.Errorf(codes.InvalidArgument, "prior_alpha components must be positive")
+			}
+		}
+		priorAlpha = normalizeWeights([3]float32{req.PriorAlpha[0], req.PriorAlpha[1], req.PriorAlpha[2]})
+	}
+
 	s.uncertaintyMu.Lock()
 	s.uncertainty = req.Lambda
+	if setPrior {
+		s.priorAlpha = priorAlpha
+	}
+	currentPrior := s.priorAlpha
 	s.uncertaintyMu.Unlock()
 
 	uncertaintyLevel.Set(float64(req.Lambda))
 
+	// Broadcast the global uncertainty change on the watch stream too; PolicyID is left
+	// empty since λ is not scoped to a single policy, so only unfiltered subscribers see it.
+	s.eventHub.publish(ctx, &StateEvent{})
+
 	var description string
 	if req.Lambda > 0.7 {
 		description = "High accuracy mode: prioritizing thorough validation over speed"
@@ -506,6 +746,7 @@ func (s *server) SetUncertainty(ctx context.Context, req *pb.UncertaintyRequest)
 	return &pb.UncertaintyResponse{
 		Lambda:            req.Lambda,
 		EffectDescription: description,
+		PriorAlpha:        []float32{currentPrior[0], currentPrior[1], currentPrior[2]},
 	}, nil
 }
 
@@ -518,16 +759,42 @@ func (s *server) Observe(ctx context.Context, req *pb.ObserveRequest) (*pb.Obser
 	}
 
 	wasCollapsed := policy.IsCollapsed
+	weightsBefore := [3]float32{policy.WeightApproved, policy.WeightRejected, policy.WeightPending}
 	var finalState pb.State
 
 	if !policy.IsCollapsed {
-		// Observer effect triggers collapse
-		finalState = s.collapseWaveFunction(policy, pb.CollapseReason_OBSERVATION)
-
-		// Update policy in Redis
-		err = s.updatePolicy(ctx, policy)
+		// Observer effect triggers collapse, guarded by the same CAS path as Measure, and
+		// subject to the same cluster quorum round when one is configured (see
+		// resolveCollapse); a QUORUM_MISMATCH leaves the policy in superposition.
+		agreedState, reason := s.resolveCollapse(ctx, policy, pb.CollapseReason_OBSERVATION)
+
+		updated, err := s.updatePolicy(ctx, req.PolicyId, func(cur *QuantumPolicyInternal) (*QuantumPolicyInternal, error) {
+			if cur.IsCollapsed || reason == pb.CollapseReason_QUORUM_MISMATCH {
+				return nil, errNoUpdateNeeded
+			}
+			if agreedState != nil {
+				return collapseToState(cur, *agreedState), nil
+			}
+			bias := s.criticalityBias(cur.PolicyID, cur.Criticality)
+			lambda := s.effectiveLambda(cur.PolicyID, cur.UncertaintyParameter)
+			return collapseWaveFunctionBiased(cur, reason, probabilisticCollapse, bias, lambda), nil
+		})
 		if err != nil {
-			log.Printf("Failed to update policy after observation: %v", err)
+			return nil, err
+		}
+		policy = updated
+		finalState = policy.CollapsedState
+
+		if !wasCollapsed && policy.IsCollapsed {
+			s.recordCollapseMetrics(policy, pb.CollapseReason_OBSERVATION)
+			s.eventHub.publish(ctx, &StateEvent{
+				PolicyID:        policy.PolicyID,
+				EntanglementTag: policy.EntanglementTag,
+				WeightsBefore:   weightsBefore,
+				WeightsAfter:    [3]float32{policy.WeightApproved, policy.WeightRejected, policy.WeightPending},
+				State:           finalState,
+				CollapseReason:  pb.CollapseReason_OBSERVATION,
+			})
 		}
 	} else {
 		finalState = policy.CollapsedState
@@ -620,6 +887,8 @@ func (s *server) HealthCheck(ctx context.Context, req *pb.HealthCheckRequest) (*
 }
 
 func main() {
+	flag.Parse()
+
 	// Initialize Redis client
 	redisURL := os.Getenv("REDIS_URL")
 	if redisURL == "" {
@@ -643,20 +912,42 @@ func main() {
 		CollapseReasons:   make(map[string]int64),
 	}
 
+	// Resolve the configured policy evaluator(s): repeatable --evaluator flags take
+	// precedence, falling back to the single PGC_SERVICE_URL env var for compatibility
+	evaluatorURLList := []string(evaluatorURLs)
+	if len(evaluatorURLList) == 0 {
+		if url := os.Getenv("PGC_SERVICE_URL"); url != "" {
+			evaluatorURLList = []string{url}
+		}
+	}
+	evaluators, err := buildEvaluators(evaluatorURLList)
+	if err != nil {
+		log.Fatalf("Failed to configure policy evaluators: %v", err)
+	}
+
+	aggregation := AggregationStrategy(os.Getenv("PGC_AGGREGATION_STRATEGY"))
+	if aggregation == "" {
+		aggregation = AggregationFirstSuccess
+	}
+
 	// Create server
 	srv := &server{
-		redisClient:   redisClient,
-		uncertainty:   defaultUncertainty,
-		pgcServiceURL: os.Getenv("PGC_SERVICE_URL"),
-		metrics:       metrics,
+		redisClient: redisClient,
+		uncertainty: defaultUncertainty,
+		priorAlpha:  [3]float32{defaultWeightApproved, defaultWeightRejected, defaultWeightPending},
+		evaluators:  evaluators,
+		aggregation: aggregation,
+		metrics:     metrics,
+		eventHub:    newEventHub(redisClient),
 	}
 
 	// Set initial uncertainty level
 	uncertaintyLevel.Set(float64(defaultUncertainty))
 
-	// Start Prometheus metrics server
+	// Start Prometheus metrics server, plus the SSE bridge for state transition events
 	go func() {
 		http.Handle("/metrics", promhttp.Handler())
+		http.HandleFunc("/events", srv.sseHandler)
 		log.Printf("Prometheus metrics server listening on :8013")
 		log.Fatal(http.ListenAndServe(":8013", nil))
 	}()
@@ -686,9 +977,50 @@ func main() {
 		srv.redisClient.Close()
 	}()
 
+	// Optionally enable quorum-signed collapse across a peer set: unset (the default)
+	// leaves srv.cluster nil and Measure/Observe behave exactly as a single Redis-backed
+	// replica always has.
+	if quorumSize := os.Getenv("CLUSTER_QUORUM_SIZE"); quorumSize != "" {
+		size, parseErr := strconv.Atoi(quorumSize)
+		if parseErr != nil || size < 1 {
+			log.Fatalf("Invalid CLUSTER_QUORUM_SIZE=%q: must be a positive integer", quorumSize)
+		}
+		var peers []string
+		if raw := os.Getenv("CLUSTER_PEERS"); raw != "" {
+			peers = strings.Split(raw, ",")
+		}
+		nodeID, hostErr := os.Hostname()
+		if hostErr != nil || nodeID == "" {
+			nodeID = fmt.Sprintf("qpe-%d", os.Getpid())
+		}
+		srv.cluster = cluster.NewCoordinator(nodeID, redisClient, peers, size, recomputeCollapse)
+		go srv.cluster.Run(ctx)
+		log.Printf("Cluster quorum enabled: node=%s peers=%v quorum=%d", nodeID, peers, size)
+	}
+
+	// Start the deadline reaper so policies past their deadline collapse even without a
+	// Measure/Observe call; QPE_REAPER_INTERVAL_MS overrides the default sweep interval.
+	reaperInterval := defaultReaperInterval
+	if ms := os.Getenv("QPE_REAPER_INTERVAL_MS"); ms != "" {
+		if parsed, err := time.ParseDuration(ms + "ms"); err == nil {
+			reaperInterval = parsed
+		} else {
+			log.Printf("Invalid QPE_REAPER_INTERVAL_MS=%q, using default %s: %v", ms, defaultReaperInterval, err)
+		}
+	}
+	reaper := newDeadlineReaper(srv, reaperInterval)
+	go reaper.run(ctx)
+
+	// Watch for hot-reloadable uncertainty/criticality-bias config pushes; unless something
+	// publishes to configUpdatesChannel, srv.effectiveConfig() stays at defaultPolicyConfig()
+	// and behavior is identical to before this watcher existed.
+	configWatcher := newPolicyConfigWatcher(srv)
+	go configWatcher.run(ctx)
+
 	log.Printf("QPE service listening at %v", lis.Addr())
 	log.Printf("Constitutional hash: %s", constitutionalHash)
-	log.Printf("PGC service URL: %s", srv.pgcServiceURL)
+	log.Printf("Policy evaluators: %v (aggregation=%s)", evaluatorURLList, aggregation)
+	log.Printf("Deadline reaper interval: %s", reaperInterval)
 
 	if err := grpcServer.Serve(lis); err != nil {
 		log.Fatalf("Failed to serve: %v", err)
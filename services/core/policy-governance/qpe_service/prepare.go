@@ -0,0 +1,151 @@
+// Two-phase collapse for the Quantum Policy Evaluator, modeled on ABCI++'s prepare-proposal
+// step. PrepareMeasure computes the would-be collapsed state, the seed behind it, and the
+// current superposition's entropy, and hands back a short-lived commitment token, without
+// writing anything to the policy record. A subsequent Measure call carrying that token commits
+// exactly the previewed outcome instead of recomputing one; without a token, Measure behaves
+// exactly as it did before this file existed. This lets a caller (the PGC service, a
+// stakeholder tool) preview which way a policy would collapse under current weights and
+// uncertainty, run its own checks against the tentative outcome, and only commit if acceptable.
+
+package main
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"log"
+	mathrand "math/rand"
+	"time"
+
+	"github.com/go-redis/redis/v8"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	pb "qpe_service/proto"
+)
+
+// pendingCommitmentKeyPrefix namespaces commitment tokens in Redis, separately from
+// policyKeyPrefix records.
+const pendingCommitmentKeyPrefix = "qpe:pending:"
+
+// pendingCommitment is the Redis-stored record behind a commitment token: exactly the
+// collapse outcome PrepareMeasure previewed. It's kept alive only until the policy's own
+// deadline, so a stale token can never commit a collapse after Measure (or the deadline
+// reaper) would have forced a DEADLINE_EXPIRED collapse anyway.
+type pendingCommitment struct {
+	PolicyID string            `json:"policy_id"`
+	Seed     int64             `json:"seed"`
+	State    pb.State          `json:"state"`
+	Reason   pb.CollapseReason `json:"reason"`
+}
+
+// commitmentToken derives a short-lived, unforgeable token for a (policy_id, seed) pair via
+// HMAC(constitutionalHash, ...), the same construction generateEntanglementTag uses, so a
+// Measure call can't replay an arbitrary token for a different policy or a previewed outcome
+// it was never issued.
+func commitmentToken(policyID string, seed int64) string {
+	h := hmac.New(sha256.New, []byte(constitutionalHash))
+	h.Write([]byte(fmt.Sprintf("%s:%d", policyID, seed)))
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+// PrepareMeasure previews the collapse a Measure call would commit right now, without
+// persisting it. It applies the same criticality/per-policy bias and effective uncertainty λ
+// (see server.criticalityBias, server.effectiveLambda) Measure and Observe apply. Unlike a
+// Measure call without a token, it does not call the policy evaluator, apply its Bayesian
+// feedback, or run a cluster quorum round first, so the preview is computed from the weights
+// as currently stored and can differ from what such a Measure call would commit. Already-collapsed
+// policies just report their fixed state; there is nothing to preview once a policy has left
+// superposition.
+func (s *server) PrepareMeasure(ctx context.Context, req *pb.PrepareMeasureRequest) (*pb.PrepareMeasureResponse, error) {
+	policy, err := s.getPolicy(ctx, req.PolicyId)
+	if err != nil {
+		return nil, err
+	}
+	if !verifyEntanglementTag(policy.PolicyID, policy.EntanglementTag) {
+		return nil, status.Errorf(codes.DataLoss, "entanglement tag verification failed")
+	}
+
+	weights := []float32{policy.WeightApproved, policy.WeightRejected, policy.WeightPending}
+	entropy := calculateSuperpositionEntropy(weights)
+
+	if policy.IsCollapsed {
+		return &pb.PrepareMeasureResponse{
+			PolicyId:            req.PolicyId,
+			State:               policy.CollapsedState,
+			Entropy:             entropy,
+			WasAlreadyCollapsed: true,
+		}, nil
+	}
+
+	ttl := time.Until(time.Unix(policy.DeadlineAt, 0))
+	if ttl <= 0 {
+		return nil, status.Errorf(codes.FailedPrecondition, "policy %s deadline has already passed; call Measure to force a deadline collapse", req.PolicyId)
+	}
+
+	reason := s.determineCollapseReason(policy)
+	seed := mathrand.Int63()
+	bias := s.criticalityBias(policy.PolicyID, policy.Criticality)
+	lambda := s.effectiveLambda(policy.PolicyID, policy.UncertaintyParameter)
+	wouldBe := collapseWaveFunctionBiased(policy, reason, func(w []float32) pb.State {
+		return probabilisticCollapseSeeded(w, seed)
+	}, bias, lambda)
+
+	commitment := pendingCommitment{
+		PolicyID: req.PolicyId,
+		Seed:     seed,
+		State:    wouldBe.CollapsedState,
+		Reason:   reason,
+	}
+	commitmentJSON, err := json.Marshal(commitment)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to marshal pending commitment: %v", err)
+	}
+
+	token := commitmentToken(req.PolicyId, seed)
+	if err := s.redisClient.Set(ctx, pendingCommitmentKeyPrefix+token, commitmentJSON, ttl).Err(); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to store pending commitment: %v", err)
+	}
+
+	log.Printf("QPE prepare-measure: policy=%s, would-be state=%s, seed=%d, entropy=%.3f",
+		req.PolicyId, wouldBe.CollapsedState.String(), seed, entropy)
+
+	return &pb.PrepareMeasureResponse{
+		PolicyId:        req.PolicyId,
+		State:           wouldBe.CollapsedState,
+		Seed:            seed,
+		Entropy:         entropy,
+		CommitmentToken: token,
+		CollapseReason:  reason,
+	}, nil
+}
+
+// consumeCommitment atomically fetches and deletes a pending commitment via GETDEL, so two
+// Measure calls racing the same token can't both observe it before either deletes it; only
+// the caller that wins the GETDEL ever sees the commitment. A missing token means it expired,
+// was already consumed, or never existed, and a policy_id mismatch means it was issued for a
+// different policy entirely.
+func (s *server) consumeCommitment(ctx context.Context, policyID, token string) (*pendingCommitment, error) {
+	key := pendingCommitmentKeyPrefix + token
+
+	commitmentJSON, err := s.redisClient.GetDel(ctx, key).Result()
+	if err == redis.Nil {
+		return nil, status.Errorf(codes.NotFound, "commitment token for policy %s not found or expired", policyID)
+	}
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to load pending commitment: %v", err)
+	}
+
+	var commitment pendingCommitment
+	if err := json.Unmarshal([]byte(commitmentJSON), &commitment); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to unmarshal pending commitment: %v", err)
+	}
+	if commitment.PolicyID != policyID {
+		return nil, status.Errorf(codes.InvalidArgument, "commitment token does not belong to policy %s", policyID)
+	}
+
+	return &commitment, nil
+}
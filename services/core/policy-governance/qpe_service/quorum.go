@@ -0,0 +1,107 @@
+// Cluster quorum wiring for the Quantum Policy Evaluator. By default s.cluster is nil and
+// Measure/Observe behave exactly as a single Redis-backed replica always has; configuring
+// CLUSTER_PEERS/CLUSTER_QUORUM_SIZE (see main()) switches a collapse decision over to a
+// quorum-signed round run by the cluster package, so no single replica can unilaterally
+// decide a policy's fate. Two collapse paths do not run a quorum round even then: a Measure
+// call that redeems a PrepareMeasure commitment token commits the previewed state directly,
+// and the deadline reaper commits its DEADLINE_EXPIRED collapse directly.
+
+package main
+
+import (
+	"context"
+	"fmt"
+	"log"
+	mathrand "math/rand"
+
+	"qpe_service/cluster"
+
+	pb "qpe_service/proto"
+)
+
+// parseCollapseReason reverses pb.CollapseReason.String(), since CollapseProposal carries the
+// reason as a string to keep the cluster package independent of QPE's generated proto types.
+func parseCollapseReason(s string) (pb.CollapseReason, error) {
+	for _, reason := range []pb.CollapseReason{
+		pb.CollapseReason_MEASUREMENT,
+		pb.CollapseReason_OBSERVATION,
+		pb.CollapseReason_DETERMINISTIC,
+		pb.CollapseReason_DEADLINE_EXPIRED,
+		pb.CollapseReason_MANUAL,
+	} {
+		if reason.String() == s {
+			return reason, nil
+		}
+	}
+	return 0, fmt.Errorf("unrecognized collapse reason %q", s)
+}
+
+// recomputeCollapse is the cluster.Recompute callback every peer runs against an incoming
+// CollapseProposal: it reruns the same weight-driven collapse decision a CAS commit would,
+// using the proposal's shared seed (via probabilisticCollapseSeeded) instead of crypto/rand,
+// and the proposer's criticality bias and effective λ (via collapseWaveFunctionBiased) instead
+// of re-resolving its own, so every peer derives the identical result from identical inputs.
+func recomputeCollapse(p cluster.CollapseProposal) (int32, error) {
+	reason, err := parseCollapseReason(p.Reason)
+	if err != nil {
+		return 0, err
+	}
+
+	policy := &QuantumPolicyInternal{
+		PolicyID:       p.PolicyID,
+		WeightApproved: p.WeightApproved,
+		WeightRejected: p.WeightRejected,
+		WeightPending:  p.WeightPending,
+		Criticality:    p.Criticality,
+	}
+	collapsed := collapseWaveFunctionBiased(policy, reason, func(weights []float32) pb.State {
+		return probabilisticCollapseSeeded(weights, p.Seed)
+	}, p.Bias, p.Lambda)
+	return int32(collapsed.CollapsedState), nil
+}
+
+// resolveCollapse decides the final CollapseReason for a not-yet-collapsed policy and,
+// when a quorum coordinator is configured, also decides the exact agreed State up front by
+// running a PrepareCollapse round. With no coordinator configured (the default) it returns
+// (nil, reason) unchanged, leaving the caller to fall back to collapseWaveFunctionBiased inside the
+// regular updatePolicy CAS loop exactly as before clustering existed. Once a coordinator is
+// configured, a round that times out without quorum OR that outright fails to run (Redis
+// publish error, marshal error, a canceled ctx) both surface as QUORUM_MISMATCH — neither is
+// allowed to fall through to an unsupervised single-replica collapse.
+func (s *server) resolveCollapse(ctx context.Context, policy *QuantumPolicyInternal, reason pb.CollapseReason) (agreedState *pb.State, finalReason pb.CollapseReason) {
+	if s.cluster == nil {
+		return nil, reason
+	}
+
+	seed := mathrand.Int63()
+	proposal := cluster.CollapseProposal{
+		PolicyID:       policy.PolicyID,
+		Seed:           seed,
+		WeightApproved: policy.WeightApproved,
+		WeightRejected: policy.WeightRejected,
+		WeightPending:  policy.WeightPending,
+		Reason:         reason.String(),
+		Criticality:    policy.Criticality,
+		Bias:           s.criticalityBias(policy.PolicyID, policy.Criticality),
+		Lambda:         s.effectiveLambda(policy.PolicyID, policy.UncertaintyParameter),
+	}
+	roundID := fmt.Sprintf("%s:%d", policy.PolicyID, seed)
+
+	state, reached, err := s.cluster.PrepareCollapse(ctx, roundID, proposal)
+	if err != nil {
+		// A failed round (Redis publish error, marshal error, ctx canceled mid-round) must not
+		// fall back to an unsupervised single-replica collapse — that would let a transient
+		// Redis blip silently hand the exact single-point-of-trust decision clustering exists
+		// to prevent back to whichever replica happened to be running Measure/Observe. Treat it
+		// the same as a round that failed to reach quorum.
+		log.Printf("Cluster quorum round %s failed for %s: %v", roundID, policy.PolicyID, err)
+		return nil, pb.CollapseReason_QUORUM_MISMATCH
+	}
+	if !reached {
+		log.Printf("Cluster quorum not reached for %s (round %s)", policy.PolicyID, roundID)
+		return nil, pb.CollapseReason_QUORUM_MISMATCH
+	}
+
+	agreed := pb.State(state)
+	return &agreed, reason
+}
@@ -0,0 +1,180 @@
+// Deadline-driven background collapser for the Quantum Policy Evaluator.
+// Measure checks a policy's deadline only when it is called; the
+// deadlineReaper instead sweeps a Redis sorted set of pending deadlines on a ticker and
+// collapses anything that has expired, so qpe_policies_in_superposition doesn't grow
+// unbounded for policies nobody happens to query.
+
+package main
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/go-redis/redis/v8"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+
+	pb "qpe_service/proto"
+)
+
+const (
+	// deadlinesKey is a Redis sorted set of policy_id members scored by DeadlineAt (unix
+	// seconds), populated by Register and drained by the reaper.
+	deadlinesKey = "qpe:deadlines"
+	// reaperLockKey is held by whichever replica is sweeping for a given tick.
+	reaperLockKey = "qpe:reaper:leader"
+	// reaperFenceKey hands out a monotonically increasing fencing token to each leader
+	// election attempt, so log lines (and any future downstream consumer) can tell sweeps
+	// from different elected leaders apart even if they overlap.
+	reaperFenceKey = "qpe:reaper:fence"
+	// defaultReaperInterval is how often the reaper sweeps when QPE_REAPER_INTERVAL_MS
+	// isn't set.
+	defaultReaperInterval = 1 * time.Second
+	// reaperClaimBatch bounds how many expired policies a single sweep claims, so one
+	// slow sweep can't hold the leader lock indefinitely.
+	reaperClaimBatch = 100
+)
+
+var (
+	deadlineReaperLag = promauto.NewGauge(
+		prometheus.GaugeOpts{
+			Name: "qpe_deadline_reaper_lag_seconds",
+			Help: "Seconds between a policy's deadline and when the reaper collapsed it",
+		},
+	)
+
+	deadlineCollapsesTotal = promauto.NewCounter(
+		prometheus.CounterOpts{
+			Name: "qpe_deadline_collapses_total",
+			Help: "Total number of policies collapsed by the deadline reaper",
+		},
+	)
+)
+
+// claimExpiredScript atomically claims up to ARGV[2] (reaperClaimBatch) qpe:deadlines members
+// scored at or below the given timestamp: ZRANGEBYSCORE to find them, ZREM to remove them, in a single round trip
+// so two reaper instances racing the same sweep can never both claim a policy_id.
+var claimExpiredScript = redis.NewScript(`
+local ids = redis.call("ZRANGEBYSCORE", KEYS[1], "0", ARGV[1], "LIMIT", 0, ARGV[2])
+if #ids > 0 then
+	redis.call("ZREM", KEYS[1], unpack(ids))
+end
+return ids
+`)
+
+// deadlineReaper sweeps deadlinesKey on a ticker and collapses every policy whose deadline
+// has passed, via the same CAS updatePolicy path Measure/Observe use. Leadership for each
+// sweep is decided by a Redis SET NX PX lock so exactly one replica sweeps at a time.
+type deadlineReaper struct {
+	srv      *server
+	interval time.Duration
+	nodeID   string
+}
+
+// newDeadlineReaper builds a reaper for srv; interval <= 0 falls back to
+// defaultReaperInterval.
+func newDeadlineReaper(srv *server, interval time.Duration) *deadlineReaper {
+	if interval <= 0 {
+		interval = defaultReaperInterval
+	}
+	return &deadlineReaper{srv: srv, interval: interval, nodeID: randomNodeID()}
+}
+
+func randomNodeID() string {
+	buf := make([]byte, 8)
+	if _, err := rand.Read(buf); err != nil {
+		return "reaper-unknown"
+	}
+	return hex.EncodeToString(buf)
+}
+
+// run sweeps on the reaper's interval until ctx is canceled.
+func (r *deadlineReaper) run(ctx context.Context) {
+	ticker := time.NewTicker(r.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			r.sweepOnce(ctx)
+		}
+	}
+}
+
+// sweepOnce attempts to become this tick's leader and, if elected, claims and collapses
+// up to reaperClaimBatch expired policies; any beyond that wait for a later sweep.
+func (r *deadlineReaper) sweepOnce(ctx context.Context) {
+	fenceToken, err := r.srv.redisClient.Incr(ctx, reaperFenceKey).Result()
+	if err != nil {
+		log.Printf("Deadline reaper: failed to allocate fencing token: %v", err)
+		return
+	}
+
+	acquired, err := r.srv.redisClient.SetNX(ctx, reaperLockKey, fmt.Sprintf("%s:%d", r.nodeID, fenceToken), r.interval).Result()
+	if err != nil {
+		log.Printf("Deadline reaper: leader election check failed: %v", err)
+		return
+	}
+	if !acquired {
+		return // another replica holds the lock for this tick
+	}
+
+	now := time.Now().Unix()
+	ids, err := claimExpiredScript.Run(ctx, r.srv.redisClient, []string{deadlinesKey}, now, reaperClaimBatch).StringSlice()
+	if err != nil && err != redis.Nil {
+		log.Printf("Deadline reaper (fence=%d): failed to claim expired policies: %v", fenceToken, err)
+		return
+	}
+
+	for _, policyID := range ids {
+		r.collapseExpired(ctx, policyID, now, fenceToken)
+	}
+}
+
+// collapseExpired runs the DEADLINE_EXPIRED collapse for a single claimed policy_id through
+// the normal CAS updatePolicy path, then records metrics and publishes the resulting event
+// exactly as Measure/Observe would.
+func (r *deadlineReaper) collapseExpired(ctx context.Context, policyID string, now, fenceToken int64) {
+	var alreadyCollapsed bool
+	var deadlineAt int64
+	var weightsBefore [3]float32
+
+	updated, err := r.srv.updatePolicy(ctx, policyID, func(cur *QuantumPolicyInternal) (*QuantumPolicyInternal, error) {
+		alreadyCollapsed = cur.IsCollapsed
+		deadlineAt = cur.DeadlineAt
+		weightsBefore = [3]float32{cur.WeightApproved, cur.WeightRejected, cur.WeightPending}
+		if cur.IsCollapsed {
+			return nil, errNoUpdateNeeded
+		}
+		return collapseWaveFunction(cur, pb.CollapseReason_DEADLINE_EXPIRED), nil
+	})
+	if err != nil {
+		log.Printf("Deadline reaper (fence=%d): failed to collapse %s: %v", fenceToken, policyID, err)
+		return
+	}
+	if alreadyCollapsed {
+		return
+	}
+
+	r.srv.recordCollapseMetrics(updated, pb.CollapseReason_DEADLINE_EXPIRED)
+	deadlineCollapsesTotal.Inc()
+	deadlineReaperLag.Set(float64(now - deadlineAt))
+
+	r.srv.eventHub.publish(ctx, &StateEvent{
+		PolicyID:        updated.PolicyID,
+		EntanglementTag: updated.EntanglementTag,
+		WeightsBefore:   weightsBefore,
+		WeightsAfter:    [3]float32{updated.WeightApproved, updated.WeightRejected, updated.WeightPending},
+		State:           updated.CollapsedState,
+		CollapseReason:  pb.CollapseReason_DEADLINE_EXPIRED,
+	})
+
+	log.Printf("Deadline reaper (fence=%d): collapsed %s to %s (lag=%ds)",
+		fenceToken, policyID, updated.CollapsedState.String(), now-deadlineAt)
+}